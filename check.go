@@ -0,0 +1,415 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"io"
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Options structure to hold the check subcommand's flags
+type Options struct {
+	FileName    string        // FileName to read the list of names from ... OR
+	Names       string        // List of Names to check, separated by comma (takes precedence over FileName)
+	TLDs        string        // List of TLDs to check
+	Output      string        // Output file name
+	Verbose     bool          // Verbose mode
+	Json        bool          // Output JSON
+	Concurrency int           // Number of worker goroutines used to perform lookups
+	Rate        float64       // Maximum lookups per second across all workers, 0 means unlimited
+	Format      string        // Output format: text, json, ndjson, csv, or template
+	Template    string        // Go template used when Format is "template"
+	Watch       time.Duration // Re-check interval, 0 disables watch mode
+	Notify      []string      // Notification sinks: "stderr" and/or webhook URLs
+	OnChange    string        // Executable invoked with NAME/TLD/STATE env vars on a change
+	StateFile   string        // Where watch mode persists last-known availability between runs
+	Strict      bool          // Fail on invalid/non-IDNA-compliant names instead of skipping them
+	TLDsList    []string      // List of TLDs to check
+	NamesList   []string      // List of names either from the command line or read from file
+}
+
+// TLD structure to keep the top-level domain availability data. Will be nested in the Result structure
+type TLD struct {
+	TLDName     string    `json:"top_level_domain"`
+	IsAvailable bool      `json:"is_available"`
+	CheckedAt   time.Time `json:"checked_at"`
+}
+
+// Result structure to keep the results of the check
+type Result struct {
+	Name      string `json:"domain_name"`       // Original, possibly unicode, input form
+	ASCIIName string `json:"domain_name_ascii"` // IDNA2008 A-label (punycode) form used for the lookup
+	TLDList   []TLD  `json:"tlds"`
+}
+
+var checkOpts Options
+
+// checkCmd implements the original dn-check behaviour: look up every
+// name x TLD combination and report availability.
+var checkCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Check availability of names across TLDs",
+	RunE:  runCheck,
+}
+
+func init() {
+	flags := checkCmd.Flags()
+	flags.StringVarP(&checkOpts.FileName, "names-file", "f", "", "File name to read the list of names from, one name per line. Superseded by --names.")
+	flags.StringVarP(&checkOpts.Names, "names", "n", "", "List of names to check, separated by comma. Takes precedence over --names-file.")
+	flags.StringVarP(&checkOpts.TLDs, "tlds", "d", "com", "Comma separated list of TLDs to check.")
+	flags.StringVarP(&checkOpts.Output, "output", "o", "", "Spool output to a `filename` provided.")
+	flags.BoolVarP(&checkOpts.Json, "json", "j", false, "Output using JSON format. Shorthand for --format=json.")
+	flags.BoolVarP(&checkOpts.Verbose, "verbose", "v", false, "Enable verbose mode.")
+	flags.IntVarP(&checkOpts.Concurrency, "concurrency", "c", 10, "Number of concurrent workers used to perform lookups.")
+	flags.Float64VarP(&checkOpts.Rate, "rate", "r", 0, "Maximum number of lookups per second across all workers, 0 disables the limit.")
+	flags.StringVar(&checkOpts.Format, "format", "text", "Output format: text, json, ndjson, csv, or template.")
+	flags.StringVar(&checkOpts.Template, "template", "", "Go template applied to each result when --format=template.")
+	flags.DurationVar(&checkOpts.Watch, "watch", 0, "Keep re-checking every given interval (e.g. 5m) and report availability changes. 0 disables watch mode.")
+	flags.StringSliceVar(&checkOpts.Notify, "notify", []string{"stderr"}, "Notification sinks for watch mode: \"stderr\" and/or webhook URLs, comma separated.")
+	flags.StringVar(&checkOpts.OnChange, "on-change", "", "Executable invoked on each availability change in watch mode, with NAME, TLD, and STATE env vars set.")
+	flags.StringVar(&checkOpts.StateFile, "state-file", "", "File used to persist watch mode's last-known availability between runs. Defaults to a path under the user's cache directory.")
+	flags.BoolVar(&checkOpts.Strict, "strict", false, "Fail on names that violate IDNA/LDH rules instead of skipping them with a warning.")
+	rootCmd.AddCommand(checkCmd)
+}
+
+// Lookup a domain name using the given context. Return true if the name is available, otherwise false
+func isDomainNameAvailable(ctx context.Context, domain string) (bool, error) {
+	_, err := net.DefaultResolver.LookupHost(ctx, domain)
+	if err != nil {
+		// A lookup cut short by ctx also fails with a *net.DNSError, which
+		// says nothing about the name, so report the context's error instead.
+		if ctx.Err() != nil {
+			return false, ctx.Err()
+		}
+		if _, ok := err.(*net.DNSError); ok {
+			return true, nil
+		}
+		return false, err
+	}
+	return false, nil
+}
+
+// Open filename for read and read all lines into a list
+// Returns the list of names
+func readNamesFromFile(filename string) ([]string, error) {
+	d, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	} else {
+		return strings.Split(strings.ToLower(string(d)), "\n"), nil
+	}
+}
+
+// runCheck validates the check subcommand's flags, runs the lookups and
+// renders the results, returning any error for cobra to report.
+func runCheck(cmd *cobra.Command, args []string) error {
+	opts := checkOpts
+
+	// --json is a shorthand for --format=json, kept for backwards compatibility
+	if opts.Json {
+		opts.Format = "json"
+	}
+
+	opts.TLDsList = strings.Split(opts.TLDs, ",")
+
+	if opts.Concurrency < 1 {
+		opts.Concurrency = 1
+	}
+
+	if opts.Names == "" {
+		if opts.FileName == "" {
+			return fmt.Errorf("no names provided, pass --names or --names-file")
+		}
+		var err error
+		opts.NamesList, err = readNamesFromFile(opts.FileName)
+		if err != nil {
+			return err
+		}
+	} else {
+		opts.NamesList = strings.Split(opts.Names, ",")
+	}
+
+	writer, err := NewOutputWriter(opts.Format, opts.Template)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+	defer stop()
+
+	if opts.Watch > 0 {
+		return runWatch(ctx, opts, writer)
+	}
+
+	// Streaming formats (ndjson) write each Result as soon as it's ready,
+	// instead of buffering the whole batch, so a shell pipeline can start
+	// consuming output before dn-check finishes.
+	if sw, ok := writer.(StreamingOutputWriter); ok {
+		dest, err := openOutputDest(opts.Output)
+		if err != nil {
+			return err
+		}
+		defer dest.Close()
+		_, err = run(ctx, opts, func(r Result) error { return sw.WriteOne(dest, r) })
+		return err
+	}
+
+	results, err := run(ctx, opts, nil)
+	if err != nil {
+		return err
+	}
+
+	if opts.Output != "" {
+		return SpoolOutputToFile(opts.Output, results, writer)
+	}
+	return writer.Write(os.Stdout, results)
+}
+
+// openOutputDest returns the file at path, or stdout wrapped in a no-op
+// Closer when path is empty.
+func openOutputDest(path string) (io.WriteCloser, error) {
+	if path == "" {
+		return nopCloser{os.Stdout}, nil
+	}
+	return os.Create(path)
+}
+
+// nopCloser adapts an io.Writer (stdout) to io.WriteCloser without closing it.
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error { return nil }
+
+// lookupJob describes a single name+TLD lookup, tagged with its position in
+// the output so results can be reassembled in input order once the worker
+// pool finishes.
+type lookupJob struct {
+	nameIndex int
+	tldIndex  int
+	asciiName string
+	tld       string
+}
+
+// lookupOutcome is the result of a lookupJob, carried back on the results channel.
+// invalid is set when the name.tld combination itself failed IDNA/length
+// validation, and err then holds that validation error; otherwise err covers
+// lookup failures and cancellation.
+type lookupOutcome struct {
+	lookupJob
+	available bool
+	invalid   bool
+	err       error
+}
+
+// run performs every name x TLD lookup for opts and returns the full,
+// input-ordered result set. If onResult is non-nil, it's additionally called
+// once per name, as soon as all of that name's TLDs have been checked (in
+// completion order, not input order) — this is what lets ndjson output
+// stream to pipeline consumers instead of waiting for the whole batch.
+func run(ctx context.Context, opts Options, onResult func(Result) error) ([]Result, error) {
+	names := make([]string, 0, len(opts.NamesList))
+	asciiNames := make([]string, 0, len(opts.NamesList))
+	for _, name := range opts.NamesList {
+		if name == "" {
+			// skip empty lines
+			continue
+		}
+		ascii, err := toASCII(name)
+		if err != nil {
+			if opts.Strict {
+				return nil, err
+			}
+			fmt.Fprintf(os.Stderr, "dn-check: skipping %q: %v\n", name, err)
+			continue
+		}
+		names = append(names, name)
+		asciiNames = append(asciiNames, ascii)
+	}
+
+	if opts.Verbose {
+		PrintVerboseHeader(opts)
+	}
+
+	// Buffered so the dispatcher and workers can run ahead of a slow
+	// collector instead of lockstepping on every single lookup.
+	jobs := make(chan lookupJob, opts.Concurrency)
+	outcomes := make(chan lookupOutcome, opts.Concurrency)
+
+	var limiter *time.Ticker
+	if opts.Rate > 0 {
+		interval := time.Duration(float64(time.Second) / opts.Rate)
+		if interval < time.Nanosecond {
+			interval = time.Nanosecond
+		}
+		limiter = time.NewTicker(interval)
+		defer limiter.Stop()
+	}
+
+	worker := func() {
+		for job := range jobs {
+			select {
+			case <-ctx.Done():
+				outcomes <- lookupOutcome{lookupJob: job, err: ctx.Err()}
+				continue
+			default:
+			}
+			asciiTLD, verr := validateCombo(job.asciiName, job.tld)
+			if verr != nil {
+				outcomes <- lookupOutcome{lookupJob: job, invalid: true, err: verr}
+				continue
+			}
+			if limiter != nil {
+				select {
+				case <-ctx.Done():
+					outcomes <- lookupOutcome{lookupJob: job, err: ctx.Err()}
+					continue
+				case <-limiter.C:
+				}
+			}
+			available, err := isDomainNameAvailable(ctx, job.asciiName+"."+asciiTLD)
+			outcomes <- lookupOutcome{lookupJob: job, available: available, err: err}
+		}
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(opts.Concurrency)
+	for i := 0; i < opts.Concurrency; i++ {
+		go func() {
+			defer wg.Done()
+			worker()
+		}()
+	}
+
+	go func() {
+		defer close(jobs)
+		for i, asciiName := range asciiNames {
+			for j, tld := range opts.TLDsList {
+				select {
+				case jobs <- lookupJob{nameIndex: i, tldIndex: j, asciiName: asciiName, tld: tld}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(outcomes)
+	}()
+
+	tldLists := make([][]TLD, len(names))
+	for i := range tldLists {
+		tldLists[i] = make([]TLD, len(opts.TLDsList))
+	}
+	pending := make([]int, len(names))
+	for i := range pending {
+		pending[i] = len(opts.TLDsList)
+	}
+
+	// buildResult drops combinations that failed per-TLD validation, which
+	// are left as their zero value (a never-set CheckedAt), instead of
+	// reporting a fake, unchecked result.
+	buildResult := func(i int) Result {
+		validTLDs := make([]TLD, 0, len(tldLists[i]))
+		for _, tld := range tldLists[i] {
+			if !tld.CheckedAt.IsZero() {
+				validTLDs = append(validTLDs, tld)
+			}
+		}
+		return Result{Name: names[i], ASCIIName: asciiNames[i], TLDList: validTLDs}
+	}
+
+	var firstErr error
+	for outcome := range outcomes {
+		switch {
+		case outcome.invalid:
+			if opts.Strict {
+				if firstErr == nil {
+					firstErr = outcome.err
+				}
+			} else {
+				fmt.Fprintf(os.Stderr, "dn-check: skipping %s.%s: %v\n",
+					names[outcome.nameIndex], opts.TLDsList[outcome.tldIndex], outcome.err)
+			}
+		case outcome.err != nil:
+			// SIGINT/cancellation is a clean stop, not a failure: leave this
+			// combination unchecked (it's dropped by buildResult) instead of
+			// failing the whole run and printing "context canceled".
+			if !errors.Is(outcome.err, context.Canceled) && !errors.Is(outcome.err, context.DeadlineExceeded) && firstErr == nil {
+				firstErr = outcome.err
+			}
+		default:
+			tldLists[outcome.nameIndex][outcome.tldIndex] = TLD{opts.TLDsList[outcome.tldIndex], outcome.available, time.Now()}
+		}
+
+		pending[outcome.nameIndex]--
+		if pending[outcome.nameIndex] == 0 && onResult != nil && firstErr == nil {
+			if err := onResult(buildResult(outcome.nameIndex)); err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	Results := make([]Result, len(names))
+	for i, name := range names {
+		result := buildResult(i)
+		Results[i] = result
+		if opts.Verbose {
+			fmt.Printf("%-12s ", name)
+			for _, tld := range result.TLDList {
+				VerboseOutput(tld.IsAvailable)
+			}
+			fmt.Println()
+		}
+	}
+	return Results, nil
+}
+
+// VerboseOutput : Helper function to print YES/NO
+func VerboseOutput(s bool) {
+	if s {
+		fmt.Print(color.GreenString("YES  "))
+	} else {
+		fmt.Print(color.RedString("NO   "))
+	}
+}
+
+// PrintVerboseHeader : Prints the header for the verbose output
+func PrintVerboseHeader(opts Options) {
+	fmt.Println("Checking", len(opts.NamesList), "names for", len(opts.TLDsList), "TLDs")
+	fmt.Print("Names       ")
+	for _, t := range opts.TLDsList {
+		fmt.Printf(" %-4s", t)
+	}
+	fmt.Println()
+}
+
+// SpoolOutputToFile : Output results to a file, using the OutputWriter for the requested format
+func SpoolOutputToFile(outputFileName string, results []Result, writer OutputWriter) error {
+	f, err := os.Create(outputFileName)
+	if err != nil {
+		return err
+	}
+	defer func(f *os.File) {
+		err := f.Close()
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+	}(f)
+	return writer.Write(f, results)
+}
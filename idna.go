@@ -0,0 +1,60 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+// idnaProfile enforces IDNA2008 validation (LDH rules, bidi) while mapping
+// unicode names to their ASCII-compatible punycode form. It does not check
+// label or domain length; validateCombo does that.
+var idnaProfile = idna.New(
+	idna.MapForLookup(),
+	idna.BidiRule(),
+	idna.StrictDomainName(true),
+)
+
+// maxLabelLength and maxDomainLength are the LDH label and full-domain length
+// limits shared by every TLD (RFC 1035 2.3.4).
+const (
+	maxLabelLength  = 63
+	maxDomainLength = 253
+)
+
+// toASCII converts a unicode domain label to its IDNA2008 A-label (punycode)
+// form, rejecting names that violate LDH rules. Label and total length
+// limits are not checked here; see validateCombo.
+func toASCII(name string) (string, error) {
+	ascii, err := idnaProfile.ToASCII(name)
+	if err != nil {
+		return "", fmt.Errorf("invalid name %q: %w", name, err)
+	}
+	return ascii, nil
+}
+
+// validateCombo converts tld to its ASCII A-label form and checks the label
+// and total-length limits of the composed asciiName.tld domain that is
+// actually going to be looked up, rather than just the bare name.
+func validateCombo(asciiName, tld string) (asciiTLD string, err error) {
+	asciiTLD, err = toASCII(tld)
+	if err != nil {
+		return "", fmt.Errorf("invalid tld %q: %w", tld, err)
+	}
+
+	for _, label := range strings.Split(asciiName, ".") {
+		if len(label) > maxLabelLength {
+			return "", fmt.Errorf("label %q exceeds %d characters", label, maxLabelLength)
+		}
+	}
+	if len(asciiTLD) > maxLabelLength {
+		return "", fmt.Errorf("tld %q exceeds %d characters", tld, maxLabelLength)
+	}
+
+	domain := asciiName + "." + asciiTLD
+	if len(domain) > maxDomainLength {
+		return "", fmt.Errorf("domain %q exceeds %d characters", domain, maxDomainLength)
+	}
+	return asciiTLD, nil
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/template"
+)
+
+// OutputWriter renders a set of Results to an io.Writer. Both stdout
+// rendering and SpoolOutputToFile share the same OutputWriter implementations
+// so adding a new --format only requires a new writer, not a new code path.
+type OutputWriter interface {
+	Write(w io.Writer, results []Result) error
+}
+
+// StreamingOutputWriter is implemented by formats that can emit a Result the
+// moment it's ready, instead of waiting for the full set. runCheck uses this,
+// through run()'s per-result callback, to feed ndjson output to pipeline
+// consumers as checks complete.
+type StreamingOutputWriter interface {
+	OutputWriter
+	WriteOne(w io.Writer, result Result) error
+}
+
+// NewOutputWriter builds the OutputWriter for the requested format. tmpl is
+// only used when format is "template".
+func NewOutputWriter(format string, tmpl string) (OutputWriter, error) {
+	switch format {
+	case "", "text":
+		return textOutputWriter{}, nil
+	case "json":
+		return jsonOutputWriter{}, nil
+	case "ndjson":
+		return ndjsonOutputWriter{}, nil
+	case "csv":
+		return csvOutputWriter{}, nil
+	case "template":
+		if tmpl == "" {
+			return nil, fmt.Errorf("--template format requires a --template string")
+		}
+		t, err := template.New("output").Parse(tmpl)
+		if err != nil {
+			return nil, fmt.Errorf("invalid template: %w", err)
+		}
+		return templateOutputWriter{tmpl: t}, nil
+	default:
+		return nil, fmt.Errorf("unknown output format %q", format)
+	}
+}
+
+// textOutputWriter reproduces dn-check's original plain text output.
+type textOutputWriter struct{}
+
+func (textOutputWriter) Write(w io.Writer, results []Result) error {
+	for _, result := range results {
+		for _, tld := range result.TLDList {
+			if _, err := fmt.Fprintf(w, "%s.%s : %t\n", result.Name, tld.TLDName, tld.IsAvailable); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// jsonOutputWriter reproduces dn-check's original -j/--json output: the full
+// result set as a single indented JSON array.
+type jsonOutputWriter struct{}
+
+func (jsonOutputWriter) Write(w io.Writer, results []Result) error {
+	jsonText, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(jsonText)
+	return err
+}
+
+// ndjsonOutputWriter emits one Result per line, so pipeline consumers can
+// process records as they arrive instead of waiting for the whole array.
+type ndjsonOutputWriter struct{}
+
+func (o ndjsonOutputWriter) Write(w io.Writer, results []Result) error {
+	for _, result := range results {
+		if err := o.WriteOne(w, result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteOne encodes a single Result as one ndjson line, so a caller can
+// stream results out as soon as each one is ready.
+func (ndjsonOutputWriter) WriteOne(w io.Writer, result Result) error {
+	return json.NewEncoder(w).Encode(result)
+}
+
+// csvOutputWriter emits one row per name/TLD combination.
+type csvOutputWriter struct{}
+
+func (csvOutputWriter) Write(w io.Writer, results []Result) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"name", "tld", "available", "checked_at"}); err != nil {
+		return err
+	}
+	for _, result := range results {
+		for _, tld := range result.TLDList {
+			row := []string{
+				result.Name,
+				tld.TLDName,
+				fmt.Sprintf("%t", tld.IsAvailable),
+				tld.CheckedAt.Format("2006-01-02T15:04:05Z07:00"),
+			}
+			if err := cw.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// templateOutputWriter renders each Result through a user-supplied
+// text/template, one execution per Result.
+type templateOutputWriter struct {
+	tmpl *template.Template
+}
+
+func (o templateOutputWriter) Write(w io.Writer, results []Result) error {
+	for _, result := range results {
+		if err := o.tmpl.Execute(w, result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// rootCmd is the base command executed when dn-check is invoked without a
+// subcommand. It carries no logic of its own: behaviour lives in the
+// subcommands registered in their respective init() functions.
+var rootCmd = &cobra.Command{
+	Use:   "dn-check",
+	Short: "Check domain name availability across one or more TLDs",
+	Long: `dn-check looks up whether a domain name is registered by attempting
+a DNS resolution for name.tld across the TLDs you give it.`,
+}
+
+// Execute runs the root command, returning any error it produced.
+func Execute() error {
+	return rootCmd.Execute()
+}
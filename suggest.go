@@ -0,0 +1,247 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// suggestGenOptions configures the candidate generators used by the suggest
+// subcommand, as distinct from the TLD/output flags it shares with check.
+type suggestGenOptions struct {
+	Seeds        string // Comma separated seed names to expand
+	Prefixes     string // Comma separated prefixes, e.g. "get-,try-"
+	Suffixes     string // Comma separated suffixes, e.g. "-app,-io"
+	Leet         bool   // Generate leet-style character substitutions (o->0, i->1, ...)
+	Hyphenate    bool   // Generate a hyphenated variant of each seed
+	Pluralize    bool   // Generate a pluralized variant of each seed
+	WordlistFile string // File of words, one per line, combined with each seed
+	Limit        int    // Maximum number of candidates to emit, 0 means unlimited
+}
+
+var suggestGenOpts suggestGenOptions
+var suggestCheckOpts Options
+
+// suggestCmd expands one or more seed names into a ranked list of candidate
+// names and feeds them through the same check pipeline as the check
+// subcommand, so users can discover available brandable names in one run.
+var suggestCmd = &cobra.Command{
+	Use:   "suggest",
+	Short: "Generate name variants from seeds and check their availability",
+	RunE:  runSuggest,
+}
+
+func init() {
+	genFlags := suggestCmd.Flags()
+	genFlags.StringVarP(&suggestGenOpts.Seeds, "seeds", "s", "", "Comma separated seed names to expand.")
+	genFlags.StringVar(&suggestGenOpts.Prefixes, "prefixes", "get-,try-", "Comma separated prefixes to prepend to each seed.")
+	genFlags.StringVar(&suggestGenOpts.Suffixes, "suffixes", "-app,-io,-hq", "Comma separated suffixes to append to each seed.")
+	genFlags.BoolVar(&suggestGenOpts.Leet, "leet", true, "Generate leet-style character substitutions (o->0, i->1, e->3, a->4, s->5).")
+	genFlags.BoolVar(&suggestGenOpts.Hyphenate, "hyphenate", true, "Generate a hyphenated variant of each seed.")
+	genFlags.BoolVar(&suggestGenOpts.Pluralize, "pluralize", true, "Generate a pluralized variant of each seed.")
+	genFlags.StringVar(&suggestGenOpts.WordlistFile, "wordlist", "", "File of words, one per line, combined with each seed as two-word candidates.")
+	genFlags.IntVar(&suggestGenOpts.Limit, "limit", 0, "Maximum number of candidates to check, 0 means unlimited.")
+
+	checkFlags := suggestCmd.Flags()
+	checkFlags.StringVarP(&suggestCheckOpts.TLDs, "tlds", "d", "com", "Comma separated list of TLDs to check.")
+	checkFlags.StringVarP(&suggestCheckOpts.Output, "output", "o", "", "Spool output to a `filename` provided.")
+	checkFlags.BoolVarP(&suggestCheckOpts.Json, "json", "j", false, "Output using JSON format. Shorthand for --format=json.")
+	checkFlags.BoolVarP(&suggestCheckOpts.Verbose, "verbose", "v", false, "Enable verbose mode.")
+	checkFlags.IntVarP(&suggestCheckOpts.Concurrency, "concurrency", "c", 10, "Number of concurrent workers used to perform lookups.")
+	checkFlags.Float64VarP(&suggestCheckOpts.Rate, "rate", "r", 0, "Maximum number of lookups per second across all workers, 0 disables the limit.")
+	checkFlags.StringVar(&suggestCheckOpts.Format, "format", "text", "Output format: text, json, ndjson, csv, or template.")
+	checkFlags.StringVar(&suggestCheckOpts.Template, "template", "", "Go template applied to each result when --format=template.")
+
+	rootCmd.AddCommand(suggestCmd)
+}
+
+func runSuggest(cmd *cobra.Command, args []string) error {
+	if suggestGenOpts.Seeds == "" {
+		return fmt.Errorf("no seed names provided, pass --seeds")
+	}
+	seeds := strings.Split(suggestGenOpts.Seeds, ",")
+
+	var wordlist []string
+	if suggestGenOpts.WordlistFile != "" {
+		var err error
+		wordlist, err = readNamesFromFile(suggestGenOpts.WordlistFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	candidates := generateCandidates(seeds, suggestGenOpts, wordlist)
+	if suggestGenOpts.Limit > 0 && len(candidates) > suggestGenOpts.Limit {
+		candidates = candidates[:suggestGenOpts.Limit]
+	}
+
+	opts := suggestCheckOpts
+	if opts.Json {
+		opts.Format = "json"
+	}
+	opts.TLDsList = strings.Split(opts.TLDs, ",")
+	opts.NamesList = candidates
+	if opts.Concurrency < 1 {
+		opts.Concurrency = 1
+	}
+
+	writer, err := NewOutputWriter(opts.Format, opts.Template)
+	if err != nil {
+		return err
+	}
+
+	results, err := run(cmd.Context(), opts, nil)
+	if err != nil {
+		return err
+	}
+
+	if opts.Output != "" {
+		return SpoolOutputToFile(opts.Output, results, writer)
+	}
+	return writer.Write(os.Stdout, results)
+}
+
+// generateCandidates expands seeds into a deduplicated, ranked list of
+// candidate names using the generators enabled in genOpts.
+func generateCandidates(seeds []string, genOpts suggestGenOptions, wordlist []string) []string {
+	seen := make(map[string]bool)
+	var candidates []string
+
+	add := func(name string) {
+		name = strings.TrimSpace(strings.ToLower(name))
+		if name == "" || seen[name] {
+			return
+		}
+		seen[name] = true
+		candidates = append(candidates, name)
+	}
+
+	prefixes := splitNonEmpty(genOpts.Prefixes)
+	suffixes := splitNonEmpty(genOpts.Suffixes)
+
+	for _, seed := range seeds {
+		seed = strings.TrimSpace(strings.ToLower(seed))
+		if seed == "" {
+			continue
+		}
+		add(seed)
+
+		for _, p := range prefixes {
+			add(p + seed)
+		}
+		for _, s := range suffixes {
+			add(seed + s)
+		}
+		if genOpts.Hyphenate {
+			add(hyphenate(seed))
+		}
+		if genOpts.Pluralize {
+			add(pluralize(seed))
+		}
+		if genOpts.Leet {
+			add(leetSubstitute(seed))
+		}
+		for _, word := range wordlist {
+			word = strings.TrimSpace(strings.ToLower(word))
+			if word == "" {
+				continue
+			}
+			add(seed + word)
+			add(word + seed)
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return rankCandidate(candidates[i]) < rankCandidate(candidates[j])
+	})
+	return candidates
+}
+
+func splitNonEmpty(s string) []string {
+	var out []string
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
+
+// hyphenate inserts a hyphen roughly midway through the seed, which is
+// usually enough to make simple prefix/suffix and two-word combos readable.
+func hyphenate(seed string) string {
+	if strings.Contains(seed, "-") || len(seed) < 4 {
+		return ""
+	}
+	mid := len(seed) / 2
+	return seed[:mid] + "-" + seed[mid:]
+}
+
+// pluralize applies the common English pluralization rules.
+func pluralize(seed string) string {
+	switch {
+	case strings.HasSuffix(seed, "s"), strings.HasSuffix(seed, "x"), strings.HasSuffix(seed, "z"),
+		strings.HasSuffix(seed, "ch"), strings.HasSuffix(seed, "sh"):
+		return seed + "es"
+	case strings.HasSuffix(seed, "y") && len(seed) > 1 && !isVowel(rune(seed[len(seed)-2])):
+		return seed[:len(seed)-1] + "ies"
+	default:
+		return seed + "s"
+	}
+}
+
+var leetSubstitutions = map[rune]rune{
+	'o': '0',
+	'i': '1',
+	'e': '3',
+	'a': '4',
+	's': '5',
+}
+
+// leetSubstitute replaces every substitutable letter in seed with its
+// leet-speak digit.
+func leetSubstitute(seed string) string {
+	var b strings.Builder
+	changed := false
+	for _, r := range seed {
+		if repl, ok := leetSubstitutions[r]; ok {
+			b.WriteRune(repl)
+			changed = true
+			continue
+		}
+		b.WriteRune(r)
+	}
+	if !changed {
+		return ""
+	}
+	return b.String()
+}
+
+func isVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u':
+		return true
+	default:
+		return false
+	}
+}
+
+// rankCandidate scores a candidate so that shorter, more pronounceable names
+// (closer to a natural vowel/consonant balance) sort first.
+func rankCandidate(name string) int {
+	vowels := 0
+	for _, r := range name {
+		if isVowel(r) {
+			vowels++
+		}
+	}
+	balance := len(name) - vowels*2
+	if balance < 0 {
+		balance = -balance
+	}
+	return len(name)*10 + balance
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// ianaTLDListURL is IANA's canonical, newline-delimited list of all
+// currently assigned top-level domains.
+const ianaTLDListURL = "https://data.iana.org/TLD/tlds-alpha-by-domain.txt"
+
+// tldsCmd groups the subcommands that manage dn-check's local cache of
+// known TLDs. Only `tlds list` reads the cache; check and suggest do not
+// consult it for their -d/--tlds values.
+var tldsCmd = &cobra.Command{
+	Use:   "tlds",
+	Short: "List or refresh the known set of top-level domains",
+}
+
+var tldsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Print the cached list of known TLDs",
+	RunE:  runTLDsList,
+}
+
+var tldsRefreshCmd = &cobra.Command{
+	Use:   "refresh",
+	Short: "Fetch the current TLD list from IANA and cache it locally",
+	RunE:  runTLDsRefresh,
+}
+
+func init() {
+	tldsCmd.AddCommand(tldsListCmd)
+	tldsCmd.AddCommand(tldsRefreshCmd)
+	rootCmd.AddCommand(tldsCmd)
+}
+
+// tldsCacheFile returns the path dn-check uses to cache the IANA TLD list.
+func tldsCacheFile() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "dn-check", "tlds.txt"), nil
+}
+
+func runTLDsList(cmd *cobra.Command, args []string) error {
+	path, err := tldsCacheFile()
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("no cached TLD list found, run `dn-check tlds refresh` first")
+		}
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(cmd.OutOrStdout(), f)
+	return err
+}
+
+func runTLDsRefresh(cmd *cobra.Command, args []string) error {
+	req, err := http.NewRequestWithContext(cmd.Context(), http.MethodGet, ianaTLDListURL, nil)
+	if err != nil {
+		return err
+	}
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("fetching TLD list: unexpected status %s", resp.Status)
+	}
+
+	tlds, err := parseIANATLDList(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	path, err := tldsCacheFile()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, []byte(strings.Join(tlds, "\n")+"\n"), 0o644); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Cached %d TLDs to %s\n", len(tlds), path)
+	return nil
+}
+
+// parseIANATLDList strips IANA's comment header and lower-cases each entry.
+func parseIANATLDList(r io.Reader) ([]string, error) {
+	var tlds []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		tlds = append(tlds, strings.ToLower(line))
+	}
+	return tlds, scanner.Err()
+}
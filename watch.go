@@ -0,0 +1,186 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// watchStateFile returns the default path used to persist watch mode's
+// last-known availability between runs, unless the user overrode it.
+func watchStateFile(opts Options) (string, error) {
+	if opts.StateFile != "" {
+		return opts.StateFile, nil
+	}
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "dn-check", "watch-state.json"), nil
+}
+
+// loadWatchState reads the persisted availability map, keyed by "name.tld".
+// A missing file just means this is the first run.
+func loadWatchState(path string) (map[string]bool, error) {
+	state := make(map[string]bool)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return state, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+func saveWatchState(path string, state map[string]bool) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// runWatch keeps re-checking opts.NamesList/TLDsList every opts.Watch
+// interval, notifying opts.Notify sinks and opts.OnChange whenever a
+// name.tld's availability differs from the persisted state, until ctx is
+// cancelled.
+func runWatch(ctx context.Context, opts Options, writer OutputWriter) error {
+	statePath, err := watchStateFile(opts)
+	if err != nil {
+		return err
+	}
+	state, err := loadWatchState(statePath)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(opts.Watch)
+	defer ticker.Stop()
+
+	for {
+		results, err := run(ctx, opts, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, result := range results {
+			for _, tld := range result.TLDList {
+				key := result.Name + "." + tld.TLDName
+				prev, known := state[key]
+				// Only notify on an actual change. On the first run nothing
+				// is "known" yet, so this just seeds the baseline instead of
+				// notifying for every name.tld pair.
+				if known && prev != tld.IsAvailable {
+					if err := notifyChange(ctx, opts, result.Name, tld.TLDName, tld.IsAvailable); err != nil {
+						fmt.Fprintln(os.Stderr, "dn-check: notify:", err)
+					}
+				}
+				state[key] = tld.IsAvailable
+			}
+		}
+		if err := saveWatchState(statePath, state); err != nil {
+			return err
+		}
+
+		if opts.Output != "" {
+			if err := SpoolOutputToFile(opts.Output, results, writer); err != nil {
+				return err
+			}
+		} else if err := writer.Write(os.Stdout, results); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// notifyHTTPClient is shared by every webhook notification so a hung sink
+// can't block the watch loop indefinitely, matching the client tlds.go uses
+// to fetch the IANA TLD list.
+var notifyHTTPClient = &http.Client{Timeout: 30 * time.Second}
+
+// notifyChange fans a single availability change out to every configured
+// sink: stderr, webhook URLs in opts.Notify, and opts.OnChange if set.
+func notifyChange(ctx context.Context, opts Options, name, tld string, available bool) error {
+	state := "taken"
+	if available {
+		state = "available"
+	}
+
+	var errs []string
+	for _, sink := range opts.Notify {
+		switch {
+		case sink == "stderr":
+			fmt.Fprintf(os.Stderr, "%s.%s is now %s\n", name, tld, state)
+		case strings.HasPrefix(sink, "http://"), strings.HasPrefix(sink, "https://"):
+			if err := notifyWebhook(ctx, sink, name, tld, available); err != nil {
+				errs = append(errs, err.Error())
+			}
+		default:
+			errs = append(errs, fmt.Sprintf("unknown notify sink %q", sink))
+		}
+	}
+
+	if opts.OnChange != "" {
+		if err := notifyExec(opts.OnChange, name, tld, state); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func notifyWebhook(ctx context.Context, url, name, tld string, available bool) error {
+	payload, err := json.Marshal(struct {
+		Name      string `json:"name"`
+		TLD       string `json:"tld"`
+		Available bool   `json:"available"`
+	}{Name: name, TLD: tld, Available: available})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := notifyHTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook %s returned %s", url, resp.Status)
+	}
+	return nil
+}
+
+func notifyExec(hook, name, tld, state string) error {
+	cmd := exec.Command(hook)
+	cmd.Env = append(os.Environ(), "NAME="+name, "TLD="+tld, "STATE="+state)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}